@@ -13,17 +13,17 @@
 //		}
 //
 //		// Retrieving the custom data
-//		code := era.Code(err)
+//		code := era.CodeOf(err)
 //		msg := era.Message(err)
 //		fields := era.Fields(err)
 //
-// When an error is wrapped multiple times with era, Code() and Message() will return the outermost code and message,
-// so you can overwrite this data further up the call stack where you may have more context.
+// When an error is wrapped multiple times with era, CodeOf() and Message() will return the outermost code and
+// message, so you can overwrite this data further up the call stack where you may have more context.
 //
 //		// In your service layer
 //		return era.Error(
 //			fmt.Errorf("checking password: %w", err),
-//			era.WithCode(EInvalidPassword),
+//			era.WithCode(string(EInvalidPassword)),
 //		)
 //
 //		// In your handler (assuming you return an error to a wrapping error handler that then
@@ -31,7 +31,7 @@
 //		if err := svc.Login(user, pw); err != nil {
 //			err = fmt.Errorf("logging in: %w", err)
 //			fldOpt := era.WithFields(era.F{ "user": user })
-//			if era.Code(err) == EInvalidPassword {
+//			if errors.Is(err, EInvalidPassword) {
 //				return era.New(err, era.WithMessage("Invalid login credentials."), fldOpt)
 //			}
 //			return era.New(err, era.WithMessage("Internal error."), fldOpt)
@@ -39,11 +39,30 @@
 //
 // Field data from multiple wrapper era errors is combined, with data from outermost errors taking precedence
 // if the same key exists more than once.
+//
+// Declaring codes as typed Code constants also allows idiomatic Go 1.13+ error handling with errors.Is and
+// errors.As:
+//
+//		const EInvalidPassword era.Code = "invalid_password"
+//		...
+//		if errors.Is(err, EInvalidPassword) {
+//			...
+//		}
+//		var eraErr era.Error
+//		if errors.As(err, &eraErr) {
+//			log.Print(eraErr.Code(), eraErr.Message(), eraErr.Fields())
+//		}
 
 package era
 
 import (
 	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"runtime"
+	"strings"
 )
 
 // Option is an era error option.
@@ -68,11 +87,45 @@ func (o Options) apply(e *eraError) {
 // F is a map typpe used to store error field data.
 type F map[string]interface{}
 
+// Code is an error code attached to an era error. Declaring codes as typed constants
+// lets them be used as errors.Is targets:
+//
+//		const ECodeInvalidPassword era.Code = "invalid_password"
+//		...
+//		if errors.Is(err, ECodeInvalidPassword) {
+//			...
+//		}
+type Code string
+
+// Error implements the error interface so that a Code can be used as an errors.Is target.
+func (c Code) Error() string {
+	return string(c)
+}
+
+// Error is implemented by errors created with era.New. Declaring a variable of this type
+// and passing it to errors.As retrieves the whole structured payload of the outermost era
+// error in the chain in one call:
+//
+//		var eraErr era.Error
+//		if errors.As(err, &eraErr) {
+//			log.Print(eraErr.Code(), eraErr.Message(), eraErr.Fields())
+//		}
+type Error interface {
+	error
+	Code() Code
+	Message() string
+	Fields() F
+}
+
 type eraError struct {
-	err     error
-	code    string
-	message string
-	fields  F
+	err             error
+	code            Code
+	message         string
+	fields          F
+	stack           []uintptr
+	httpStatus      int
+	sensitiveFields map[string]struct{}
+	kind            Kind
 }
 
 // Error returns the error string of the wrapped error.
@@ -85,6 +138,47 @@ func (e *eraError) Unwrap() error {
 	return e.err
 }
 
+// Is implements errors.Is support for Code sentinels: it reports whether target is a Code
+// equal to the outermost code in e's chain, as returned by CodeOf. Because errors.Is calls Is
+// on every era error in the chain, errors.Is(err, ECodeInvalidPassword) is also true when
+// ECodeInvalidPassword is set on an inner era error whose code an outer one overrides.
+func (e *eraError) Is(target error) bool {
+	code, ok := target.(Code)
+	if !ok {
+		return false
+	}
+	return CodeOf(e) == code
+}
+
+// Format implements fmt.Formatter so that "%+v" prints the error message along with its code,
+// message, fields and captured stack trace (if any), in the style of pkg/errors and merry.
+func (e *eraError) Format(s fmt.State, verb rune) {
+	switch verb {
+	case 'v':
+		if s.Flag('+') {
+			io.WriteString(s, e.Error())
+			if e.code != "" {
+				fmt.Fprintf(s, "\ncode: %s", e.code)
+			}
+			if e.message != "" {
+				fmt.Fprintf(s, "\nmessage: %s", e.message)
+			}
+			if len(e.fields) > 0 {
+				fmt.Fprintf(s, "\nfields: %v", e.fields)
+			}
+			for _, f := range Stack(e) {
+				fmt.Fprintf(s, "\n%s\n\t%s:%d", f.Function, f.File, f.Line)
+			}
+			return
+		}
+		fallthrough
+	case 's':
+		io.WriteString(s, e.Error())
+	case 'q':
+		fmt.Fprintf(s, "%q", e.Error())
+	}
+}
+
 // New creates a new error with the specified options, wrapping the passed error.
 func New(e error, opts ...Option) error {
 	err := &eraError{
@@ -101,7 +195,7 @@ func New(e error, opts ...Option) error {
 type codeOption string
 
 func (o codeOption) apply(e *eraError) {
-	e.code = string(o)
+	e.code = Code(o)
 }
 
 // WithCode is an option used to specify an error code for the error.
@@ -131,40 +225,254 @@ func WithFields(fields F) Option {
 	return fieldsOption(fields)
 }
 
-func (e *eraError) errorCode() string {
+type httpStatusOption int
+
+func (o httpStatusOption) apply(e *eraError) {
+	e.httpStatus = int(o)
+}
+
+// WithHTTPStatus is an option used to specify the HTTP status code a renderer such as
+// era/erahttp should use when turning the error into an HTTP response.
+func WithHTTPStatus(code int) Option {
+	return httpStatusOption(code)
+}
+
+type sensitiveFieldsOption []string
+
+func (o sensitiveFieldsOption) apply(e *eraError) {
+	if e.sensitiveFields == nil {
+		e.sensitiveFields = map[string]struct{}{}
+	}
+	for _, key := range o {
+		e.sensitiveFields[key] = struct{}{}
+	}
+}
+
+// WithSensitiveFields marks field keys (as used with WithFields) whose values renderers such
+// as era/erahttp should redact rather than send to a client verbatim.
+func WithSensitiveFields(keys ...string) Option {
+	return sensitiveFieldsOption(keys)
+}
+
+// Kind classifies errors into a category (NotFound, Conflict, Timeout, ...) without coupling
+// callers to string codes. Declare a Kind once with NewKind, then classify errors from any
+// package — not just era errors — with era.IsKind.
+type Kind interface {
+	// Is reports whether err belongs to this Kind.
+	Is(err error) bool
+}
+
+type kind struct {
+	name      string
+	predicate func(error) bool
+}
+
+func (k *kind) Is(err error) bool {
+	if k.predicate == nil {
+		return false
+	}
+	return k.predicate(err)
+}
+
+// NewKind creates a new error Kind identified by name (used only when printing the Kind),
+// classifying errors using predicate. predicate is run against every error in a chain, so a
+// Kind can recognize errors from other packages — wrapping context.DeadlineExceeded,
+// sql.ErrNoRows, a net.Error whose Timeout() returns true, etc. — without requiring callers
+// to convert them to era errors first.
+func NewKind(name string, predicate func(error) bool) Kind {
+	return &kind{name: name, predicate: predicate}
+}
+
+func (k *kind) String() string {
+	return k.name
+}
+
+type kindOption struct {
+	kind Kind
+}
+
+func (o kindOption) apply(e *eraError) {
+	e.kind = o.kind
+}
+
+// WithKind is an option used to classify the error with a Kind registered via NewKind.
+func WithKind(kind Kind) Option {
+	return kindOption{kind: kind}
+}
+
+type stackOption struct {
+	skip int
+}
+
+func (o stackOption) apply(e *eraError) {
+	e.stack = callers(o.skip)
+}
+
+// WithStack is an option used to capture a stack trace at the point era.New is called.
+// Stack capture is opt-in: errors created without this option incur no extra cost.
+func WithStack() Option {
+	return stackOption{}
+}
+
+// WithStackSkip is like WithStack, but skips n additional frames above the call to era.New,
+// for use in helper functions that themselves wrap era.New.
+func WithStackSkip(n int) Option {
+	return stackOption{skip: n}
+}
+
+// callers captures the program counters for the stack above its caller, skipping skip
+// additional frames on top of itself, apply and New.
+func callers(skip int) []uintptr {
+	const depth = 32
+	var pcs [depth]uintptr
+	n := runtime.Callers(4+skip, pcs[:])
+	return pcs[:n]
+}
+
+func (e *eraError) errorStack() []uintptr {
+	return e.stack
+}
+
+// Stack retrieves the stack trace captured by the innermost wrapped error created with
+// the WithStack or WithStackSkip option, or nil if no stack was captured.
+func Stack(e error) []runtime.Frame {
+	var pcs []uintptr
+	for e != nil {
+		if se, ok := e.(interface{ errorStack() []uintptr }); ok {
+			if s := se.errorStack(); len(s) > 0 {
+				pcs = s
+			}
+		}
+		e = errors.Unwrap(e)
+	}
+
+	if len(pcs) == 0 {
+		return nil
+	}
+
+	frames := runtime.CallersFrames(pcs)
+	result := make([]runtime.Frame, 0, len(pcs))
+	for {
+		frame, more := frames.Next()
+		result = append(result, frame)
+		if !more {
+			break
+		}
+	}
+	return result
+}
+
+// LogValue implements slog.LogValuer so that logging an era error with Go's slog package
+// automatically emits its code, message and fields as structured attributes, instead of
+// just the flat error string.
+func (e *eraError) LogValue() slog.Value {
+	fields := Fields(e)
+	attrs := make([]slog.Attr, 0, len(fields)+3)
+	if code := CodeOf(e); code != "" {
+		attrs = append(attrs, slog.String("code", string(code)))
+	}
+	if message := Message(e); message != "" {
+		attrs = append(attrs, slog.String("message", message))
+	}
+	for k, v := range fields {
+		attrs = append(attrs, slog.Any(k, v))
+	}
+	attrs = append(attrs, slog.String("error", e.Error()))
+	return slog.GroupValue(attrs...)
+}
+
+// Code returns the code attached directly to this error. It does not walk the wrapped
+// error chain — use the package-level CodeOf function to resolve the outermost code.
+func (e *eraError) Code() Code {
 	return e.code
 }
 
-func (e *eraError) errorMessage() string {
+// Message returns the message attached directly to this error. It does not walk the
+// wrapped error chain — use the package-level Message function to resolve the outermost
+// message.
+func (e *eraError) Message() string {
 	return e.message
 }
 
-func (e *eraError) errorFields() F {
+// Fields returns the field data attached directly to this error. It does not walk the
+// wrapped error chain — use the package-level Fields function to resolve the combined
+// field data.
+func (e *eraError) Fields() F {
 	return e.fields
 }
 
-// Code retrieves the error code of the error, or an empty string if no code is present.
-// If error codes are defined on multiple wrapped errors, the outermost code will be returned.
-func Code(e error) string {
-	for e != nil {
-		if me, ok := e.(interface{ errorCode() string }); ok && me.errorCode() != "" {
-			return me.errorCode()
+// HTTPStatus returns the HTTP status code attached directly to this error, or 0 if none was
+// set. It does not walk the wrapped error chain — use the package-level HTTPStatus function
+// to resolve the outermost status.
+func (e *eraError) HTTPStatus() int {
+	return e.httpStatus
+}
+
+// SensitiveFields returns the set of field keys marked sensitive directly on this error via
+// WithSensitiveFields. It does not walk the wrapped error chain — use the package-level
+// SensitiveFields function to resolve the combined set.
+func (e *eraError) SensitiveFields() map[string]struct{} {
+	return e.sensitiveFields
+}
+
+// Kind returns the Kind attached directly to this error, or nil if none was set. It does not
+// walk the wrapped error chain — use the package-level KindOf function to resolve the
+// outermost attached Kind.
+func (e *eraError) Kind() Kind {
+	return e.kind
+}
+
+// walk performs a stable pre-order traversal of err's wrap chain, following both the
+// single-error Unwrap() error and the multi-error Unwrap() []error conventions (as produced
+// by Join/Append). visit is called for every node; traversal stops early if visit returns
+// false.
+func walk(err error, visit func(error) bool) bool {
+	if err == nil {
+		return true
+	}
+	if !visit(err) {
+		return false
+	}
+	if m, ok := err.(interface{ Unwrap() []error }); ok {
+		for _, e := range m.Unwrap() {
+			if !walk(e, visit) {
+				return false
+			}
 		}
-		e = errors.Unwrap(e)
+		return true
 	}
-	return ""
+	if u, ok := err.(interface{ Unwrap() error }); ok {
+		return walk(u.Unwrap(), visit)
+	}
+	return true
+}
+
+// CodeOf retrieves the error code of the error, or an empty Code if no code is present.
+// If error codes are defined on multiple wrapped errors, the outermost code will be returned.
+func CodeOf(e error) Code {
+	var code Code
+	walk(e, func(err error) bool {
+		if me, ok := err.(interface{ Code() Code }); ok && me.Code() != "" {
+			code = me.Code()
+			return false
+		}
+		return true
+	})
+	return code
 }
 
 // Message retrieves the friendly message of the error, or an empty string if no message is present.
 // If messages are defined on multiple wrapped errors, the outermost message will be returned.
 func Message(e error) string {
-	for e != nil {
-		if me, ok := e.(interface{ errorMessage() string }); ok && me.errorMessage() != "" {
-			return me.errorMessage()
+	var message string
+	walk(e, func(err error) bool {
+		if me, ok := err.(interface{ Message() string }); ok && me.Message() != "" {
+			message = me.Message()
+			return false
 		}
-		e = errors.Unwrap(e)
-	}
-	return ""
+		return true
+	})
+	return message
 }
 
 // Fields retrieves the field key/value data of the error, or an empty F{} value if no field data is present.
@@ -172,10 +480,9 @@ func Message(e error) string {
 // If the same key exists in multiple wrapped errors, the value of the outermost error will be used.
 func Fields(e error) F {
 	fields := F{}
-	for e != nil {
-		if f, ok := e.(interface{ errorFields() F }); ok {
-			addFields := f.errorFields()
-			for k, v := range addFields {
+	walk(e, func(err error) bool {
+		if f, ok := err.(interface{ Fields() F }); ok {
+			for k, v := range f.Fields() {
 				// If the same key already exists, don't replace it
 				if _, ok := fields[k]; ok {
 					continue
@@ -183,7 +490,143 @@ func Fields(e error) F {
 				fields[k] = v
 			}
 		}
-		e = errors.Unwrap(e)
-	}
+		return true
+	})
 	return fields
 }
+
+// HTTPStatus retrieves the HTTP status code of the error, walking the chain for the
+// outermost non-zero status. If no status is attached anywhere in the chain, HTTPStatus
+// returns http.StatusInternalServerError (500).
+func HTTPStatus(e error) int {
+	status := http.StatusInternalServerError
+	walk(e, func(err error) bool {
+		if me, ok := err.(interface{ HTTPStatus() int }); ok && me.HTTPStatus() != 0 {
+			status = me.HTTPStatus()
+			return false
+		}
+		return true
+	})
+	return status
+}
+
+// SensitiveFields retrieves the set of field keys marked sensitive anywhere in the chain via
+// WithSensitiveFields.
+func SensitiveFields(e error) map[string]struct{} {
+	sensitive := map[string]struct{}{}
+	walk(e, func(err error) bool {
+		if me, ok := err.(interface{ SensitiveFields() map[string]struct{} }); ok {
+			for key := range me.SensitiveFields() {
+				sensitive[key] = struct{}{}
+			}
+		}
+		return true
+	})
+	return sensitive
+}
+
+// KindOf retrieves the Kind attached to the error, walking the chain for the outermost
+// attached Kind, or nil if none is attached anywhere in the chain.
+func KindOf(e error) Kind {
+	var k Kind
+	walk(e, func(err error) bool {
+		if me, ok := err.(interface{ Kind() Kind }); ok && me.Kind() != nil {
+			k = me.Kind()
+			return false
+		}
+		return true
+	})
+	return k
+}
+
+// IsKind reports whether err is classified as kind, either because kind was attached
+// directly via WithKind (the outermost attached Kind wins) or because kind's predicate
+// matches any error in the chain — including errors from other packages that were never
+// converted to era errors.
+func IsKind(err error, kind Kind) bool {
+	if kind == nil {
+		return false
+	}
+	if KindOf(err) == kind {
+		return true
+	}
+	matched := false
+	walk(err, func(e error) bool {
+		if kind.Is(e) {
+			matched = true
+			return false
+		}
+		return true
+	})
+	return matched
+}
+
+// eraMulti combines multiple errors into one, implementing the Go 1.20 Unwrap() []error
+// multi-unwrap convention so that errors.Is, errors.As and the era accessors can traverse
+// every branch.
+type eraMulti struct {
+	errs []error
+}
+
+// Error joins the messages of every combined error with a newline, matching errors.Join.
+func (m *eraMulti) Error() string {
+	var b strings.Builder
+	for i, e := range m.errs {
+		if i > 0 {
+			b.WriteByte('\n')
+		}
+		b.WriteString(e.Error())
+	}
+	return b.String()
+}
+
+// Unwrap returns the combined errors, following the Go 1.20 multi-unwrap convention.
+func (m *eraMulti) Unwrap() []error {
+	return m.errs
+}
+
+// Join combines multiple errors into one, modeled on errors.Join. Nil errors are discarded;
+// if no non-nil errors remain, Join returns nil.
+func Join(errs ...error) error {
+	nonNil := make([]error, 0, len(errs))
+	for _, e := range errs {
+		if e != nil {
+			nonNil = append(nonNil, e)
+		}
+	}
+	if len(nonNil) == 0 {
+		return nil
+	}
+	return &eraMulti{errs: nonNil}
+}
+
+// Append adds errs to dst, returning a combined error. If dst is nil, Append behaves like
+// Join. This lets services accumulate errors (e.g. field validation failures) one at a time
+// while still attaching per-error codes/fields via era.New.
+func Append(dst error, errs ...error) error {
+	all := make([]error, 0, len(errs)+1)
+	if m, ok := dst.(*eraMulti); ok {
+		all = append(all, m.errs...)
+	} else if dst != nil {
+		all = append(all, dst)
+	}
+	all = append(all, errs...)
+	return Join(all...)
+}
+
+// Errors flattens err's multi-error tree, as built by Join/Append, into the list of
+// individual errors it is composed of, in pre-order. If err is not a multi-error, Errors
+// returns a single-element slice containing err, or nil if err is nil.
+func Errors(err error) []error {
+	if err == nil {
+		return nil
+	}
+	if m, ok := err.(interface{ Unwrap() []error }); ok {
+		var result []error
+		for _, e := range m.Unwrap() {
+			result = append(result, Errors(e)...)
+		}
+		return result
+	}
+	return []error{err}
+}
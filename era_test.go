@@ -1,8 +1,12 @@
 package era_test
 
 import (
+	"context"
+	"database/sql"
 	"errors"
 	"fmt"
+	"log/slog"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -15,7 +19,7 @@ func TestEra(t *testing.T) {
 	tests := map[string]struct {
 		err         error
 		wantError   string
-		wantCode    string
+		wantCode    era.Code
 		wantMessage string
 		wantFields  era.F
 	}{
@@ -65,8 +69,8 @@ func TestEra(t *testing.T) {
 				t.Errorf("expected error: %v, got: %v", tc.wantError, tc.err.Error())
 			}
 
-			if era.Code(tc.err) != tc.wantCode {
-				t.Errorf("expected code: %v, got: %v", tc.wantCode, era.Code(tc.err))
+			if era.CodeOf(tc.err) != tc.wantCode {
+				t.Errorf("expected code: %v, got: %v", tc.wantCode, era.CodeOf(tc.err))
 			}
 
 			if era.Message(tc.err) != tc.wantMessage {
@@ -81,6 +85,235 @@ func TestEra(t *testing.T) {
 	}
 }
 
+func TestWithStack(t *testing.T) {
+	t.Parallel()
+
+	withoutStack := era.New(errors.New("an error"))
+	if frames := era.Stack(withoutStack); frames != nil {
+		t.Errorf("expected no stack, got: %v", frames)
+	}
+
+	withStack := era.New(errors.New("an error"), era.WithStack())
+	frames := era.Stack(withStack)
+	if len(frames) == 0 {
+		t.Fatal("expected a stack, got none")
+	}
+	if !strings.Contains(frames[0].Function, "TestWithStack") {
+		t.Errorf("expected innermost frame to be this test, got: %v", frames[0].Function)
+	}
+
+	wrapped := era.New(fmt.Errorf("wrapped: %w", withStack))
+	wrappedFrames := era.Stack(wrapped)
+	if len(wrappedFrames) == 0 {
+		t.Fatal("expected the innermost captured stack to be returned, got none")
+	}
+	if wrappedFrames[0].Function != frames[0].Function {
+		t.Errorf("expected innermost stack to be preserved through wrapping")
+	}
+}
+
+func TestFormat(t *testing.T) {
+	t.Parallel()
+
+	err := era.New(errors.New("an error"),
+		era.WithCode("a code"),
+		era.WithMessage("a message"),
+		era.WithFields(era.F{"key": "value"}),
+	)
+
+	plain := fmt.Sprintf("%v", err)
+	if plain != "an error" {
+		t.Errorf("expected %%v to print just the error string, got: %v", plain)
+	}
+
+	verbose := fmt.Sprintf("%+v", err)
+	for _, want := range []string{"an error", "code: a code", "message: a message", "fields: map[key:value]"} {
+		if !strings.Contains(verbose, want) {
+			t.Errorf("expected %%+v output to contain %q, got: %v", want, verbose)
+		}
+	}
+}
+
+func TestLogValue(t *testing.T) {
+	t.Parallel()
+
+	err := era.New(errors.New("an error"),
+		era.WithCode("a code"),
+		era.WithMessage("a message"),
+		era.WithFields(era.F{"key": "value"}),
+	)
+
+	lv, ok := err.(slog.LogValuer)
+	if !ok {
+		t.Fatal("expected era error to implement slog.LogValuer")
+	}
+
+	got := map[string]any{}
+	for _, attr := range lv.LogValue().Group() {
+		got[attr.Key] = attr.Value.Any()
+	}
+
+	want := map[string]any{
+		"code":    "a code",
+		"message": "a message",
+		"key":     "value",
+		"error":   "an error",
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("log attributes don't match: %v", diff)
+	}
+}
+
+const (
+	ECodeInvalidPassword era.Code = "invalid_password"
+	ECodeNotFound        era.Code = "not_found"
+)
+
+func TestCodeSentinel(t *testing.T) {
+	t.Parallel()
+
+	err := fmt.Errorf("logging in: %w", era.New(errors.New("bad password"), era.WithCode(string(ECodeInvalidPassword))))
+
+	if !errors.Is(err, ECodeInvalidPassword) {
+		t.Error("expected errors.Is to match the sentinel code")
+	}
+	if errors.Is(err, ECodeNotFound) {
+		t.Error("expected errors.Is not to match a different sentinel code")
+	}
+
+	var eraErr era.Error
+	if !errors.As(err, &eraErr) {
+		t.Fatal("expected errors.As to find the era error")
+	}
+	if eraErr.Code() != ECodeInvalidPassword {
+		t.Errorf("expected code: %v, got: %v", ECodeInvalidPassword, eraErr.Code())
+	}
+}
+
+func TestJoin(t *testing.T) {
+	t.Parallel()
+
+	err1 := era.New(errors.New("missing name"), era.WithCode("required"), era.WithFields(era.F{"field": "name"}))
+	err2 := era.New(errors.New("missing email"), era.WithCode("required"), era.WithFields(era.F{"field": "email"}))
+
+	joined := era.Join(nil, err1, nil, err2)
+
+	wantError := "missing name\nmissing email"
+	if joined.Error() != wantError {
+		t.Errorf("expected error: %q, got: %q", wantError, joined.Error())
+	}
+
+	if era.CodeOf(joined) != "required" {
+		t.Errorf("expected code: required, got: %v", era.CodeOf(joined))
+	}
+
+	gotErrors := era.Errors(joined)
+	if len(gotErrors) != 2 || gotErrors[0] != err1 || gotErrors[1] != err2 {
+		t.Errorf("expected Errors to flatten to [err1, err2], got: %v", gotErrors)
+	}
+}
+
+func TestJoinNoErrors(t *testing.T) {
+	t.Parallel()
+
+	if joined := era.Join(nil, nil); joined != nil {
+		t.Errorf("expected Join of only nils to return nil, got: %v", joined)
+	}
+}
+
+func TestAppend(t *testing.T) {
+	t.Parallel()
+
+	var combined error
+	combined = era.Append(combined, era.New(errors.New("missing name"), era.WithCode("required")))
+	combined = era.Append(combined, era.New(errors.New("missing email"), era.WithCode("required")))
+
+	gotErrors := era.Errors(combined)
+	if len(gotErrors) != 2 {
+		t.Fatalf("expected 2 combined errors, got: %v", gotErrors)
+	}
+
+	wantError := "missing name\nmissing email"
+	if combined.Error() != wantError {
+		t.Errorf("expected error: %q, got: %q", wantError, combined.Error())
+	}
+}
+
+func TestHTTPStatus(t *testing.T) {
+	t.Parallel()
+
+	if status := era.HTTPStatus(errors.New("an error")); status != 500 {
+		t.Errorf("expected default status 500, got: %v", status)
+	}
+
+	err := era.New(errors.New("not found"), era.WithHTTPStatus(404))
+	if status := era.HTTPStatus(err); status != 404 {
+		t.Errorf("expected status 404, got: %v", status)
+	}
+
+	wrapped := fmt.Errorf("wrapped: %w", err)
+	if status := era.HTTPStatus(wrapped); status != 404 {
+		t.Errorf("expected wrapped status to be preserved, got: %v", status)
+	}
+}
+
+func TestSensitiveFields(t *testing.T) {
+	t.Parallel()
+
+	err := era.New(errors.New("an error"),
+		era.WithFields(era.F{"password": "hunter2"}),
+		era.WithSensitiveFields("password"),
+	)
+
+	sensitive := era.SensitiveFields(err)
+	if _, ok := sensitive["password"]; !ok {
+		t.Errorf("expected password to be marked sensitive, got: %v", sensitive)
+	}
+}
+
+var kindNotFound = era.NewKind("not_found", func(err error) bool {
+	return errors.Is(err, sql.ErrNoRows)
+})
+
+var kindTimeout = era.NewKind("timeout", func(err error) bool {
+	return errors.Is(err, context.DeadlineExceeded)
+})
+
+func TestIsKind(t *testing.T) {
+	t.Parallel()
+
+	t.Run("attached via WithKind", func(t *testing.T) {
+		t.Parallel()
+		err := era.New(errors.New("no rows"), era.WithKind(kindNotFound))
+		if !era.IsKind(err, kindNotFound) {
+			t.Error("expected err to be classified as kindNotFound")
+		}
+		if era.IsKind(err, kindTimeout) {
+			t.Error("expected err not to be classified as kindTimeout")
+		}
+	})
+
+	t.Run("classified via predicate without conversion", func(t *testing.T) {
+		t.Parallel()
+		err := fmt.Errorf("querying user: %w", sql.ErrNoRows)
+		if !era.IsKind(err, kindNotFound) {
+			t.Error("expected sql.ErrNoRows to be classified as kindNotFound without wrapping in era.New")
+		}
+	})
+
+	t.Run("classified via predicate deep in a wrapped era error", func(t *testing.T) {
+		t.Parallel()
+		err := era.New(fmt.Errorf("querying user: %w", context.DeadlineExceeded), era.WithCode("internal"))
+		if !era.IsKind(err, kindTimeout) {
+			t.Error("expected wrapped context.DeadlineExceeded to be classified as kindTimeout")
+		}
+	})
+
+	if era.KindOf(errors.New("plain")) != nil {
+		t.Error("expected KindOf to return nil when no Kind is attached")
+	}
+}
+
 func TestEraMultipleOptions(t *testing.T) {
 	opts := era.Options{era.WithCode("abc"), era.WithMessage("def")}
 	err := era.New(errors.New("my error"), opts)
@@ -89,8 +322,8 @@ func TestEraMultipleOptions(t *testing.T) {
 		t.Errorf("expected error: my error, got: %v", err.Error())
 	}
 
-	if era.Code(err) != "abc" {
-		t.Errorf("expected code: abc, got: %v", era.Code(err))
+	if era.CodeOf(err) != "abc" {
+		t.Errorf("expected code: abc, got: %v", era.CodeOf(err))
 	}
 
 	if era.Message(err) != "def" {
@@ -0,0 +1,39 @@
+// Package erahttp renders era errors as JSON HTTP responses.
+package erahttp
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/jez321/era"
+)
+
+type errorResponse struct {
+	Code    string `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+	Fields  era.F  `json:"fields,omitempty"`
+}
+
+// WriteError renders err as a JSON error response. The status is taken from
+// era.HTTPStatus(err), and the body from era.CodeOf, era.Message and era.Fields. Field keys
+// marked sensitive anywhere in the chain via era.WithSensitiveFields are redacted rather than
+// sent to the client.
+func WriteError(w http.ResponseWriter, r *http.Request, err error) {
+	sensitive := era.SensitiveFields(err)
+	fields := era.Fields(err)
+	for key := range sensitive {
+		if _, ok := fields[key]; ok {
+			fields[key] = "[REDACTED]"
+		}
+	}
+
+	resp := errorResponse{
+		Code:    string(era.CodeOf(err)),
+		Message: era.Message(err),
+		Fields:  fields,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(era.HTTPStatus(err))
+	_ = json.NewEncoder(w).Encode(resp)
+}
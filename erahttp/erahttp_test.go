@@ -0,0 +1,64 @@
+package erahttp_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jez321/era"
+	"github.com/jez321/era/erahttp"
+)
+
+func TestWriteError(t *testing.T) {
+	t.Parallel()
+
+	err := era.New(errors.New("bad password"),
+		era.WithCode("invalid_password"),
+		era.WithMessage("Invalid login credentials."),
+		era.WithHTTPStatus(http.StatusUnauthorized),
+		era.WithFields(era.F{"user": "alice", "password": "hunter2"}),
+		era.WithSensitiveFields("password"),
+	)
+
+	rec := httptest.NewRecorder()
+	erahttp.WriteError(rec, httptest.NewRequest(http.MethodPost, "/login", nil), err)
+
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("expected status %d, got: %d", http.StatusUnauthorized, rec.Code)
+	}
+
+	var body struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+		Fields  era.F  `json:"fields"`
+	}
+	if err := json.NewDecoder(rec.Body).Decode(&body); err != nil {
+		t.Fatalf("failed to decode response body: %v", err)
+	}
+
+	if body.Code != "invalid_password" {
+		t.Errorf("expected code: invalid_password, got: %v", body.Code)
+	}
+	if body.Message != "Invalid login credentials." {
+		t.Errorf("expected message: Invalid login credentials., got: %v", body.Message)
+	}
+	if body.Fields["user"] != "alice" {
+		t.Errorf("expected user field to be passed through, got: %v", body.Fields["user"])
+	}
+	if body.Fields["password"] != "[REDACTED]" {
+		t.Errorf("expected password field to be redacted, got: %v", body.Fields["password"])
+	}
+}
+
+func TestWriteErrorDefaultStatus(t *testing.T) {
+	t.Parallel()
+
+	rec := httptest.NewRecorder()
+	erahttp.WriteError(rec, httptest.NewRequest(http.MethodGet, "/", nil), errors.New("boom"))
+
+	if rec.Code != http.StatusInternalServerError {
+		t.Errorf("expected default status %d, got: %d", http.StatusInternalServerError, rec.Code)
+	}
+}
@@ -0,0 +1,41 @@
+// Package erazap adapts era errors for structured logging with go.uber.org/zap.
+//
+// It lives in its own subpackage so that the root era package does not import zap.
+//
+// Usage:
+//
+//		logger.Error("doing something", erazap.Field(err))
+package erazap
+
+import (
+	"github.com/jez321/era"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// Field returns a zap.Field named "error" that logs the era error's code, message and
+// fields as first-class structured attributes, alongside the flat error string.
+func Field(err error) zap.Field {
+	return zap.Object("error", object{err})
+}
+
+type object struct {
+	err error
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler.
+func (o object) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if code := era.CodeOf(o.err); code != "" {
+		enc.AddString("code", string(code))
+	}
+	if message := era.Message(o.err); message != "" {
+		enc.AddString("message", message)
+	}
+	for k, v := range era.Fields(o.err) {
+		if err := enc.AddReflected(k, v); err != nil {
+			return err
+		}
+	}
+	enc.AddString("error", o.err.Error())
+	return nil
+}
@@ -0,0 +1,36 @@
+package erazap_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jez321/era"
+	"github.com/jez321/era/erazap"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestField(t *testing.T) {
+	t.Parallel()
+
+	err := era.New(errors.New("an error"),
+		era.WithCode("a code"),
+		era.WithMessage("a message"),
+		era.WithFields(era.F{"key": "value"}),
+	)
+
+	enc := zapcore.NewMapObjectEncoder()
+	if marshalErr := erazap.Field(err).Interface.(zapcore.ObjectMarshaler).MarshalLogObject(enc); marshalErr != nil {
+		t.Fatalf("unexpected error marshaling object: %v", marshalErr)
+	}
+
+	want := map[string]interface{}{
+		"code":    "a code",
+		"message": "a message",
+		"key":     "value",
+		"error":   "an error",
+	}
+	if diff := cmp.Diff(enc.Fields, want); diff != "" {
+		t.Errorf("logged fields don't match: %v", diff)
+	}
+}